@@ -6,9 +6,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/containous/flaeg/parse"
 	"github.com/containous/traefik/pkg/config"
 	"github.com/containous/traefik/pkg/middlewares"
+	"github.com/containous/traefik/pkg/middlewares/ratelimiter"
 	"github.com/containous/traefik/pkg/testhelpers"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -94,6 +97,23 @@ func TestHandler(t *testing.T) {
 				assert.NotContains(t, recorder.Body.String(), "oops", "Should not return the oops page")
 			},
 		},
+		{
+			desc:        "too many requests in a client error range",
+			errorPage:   &config.ErrorPage{Service: "error", Query: "/{status}", Status: []string{"400-499"}},
+			backendCode: http.StatusTooManyRequests,
+			backendErrorHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.RequestURI == "/429" {
+					fmt.Fprintln(w, "My 429 page.")
+				} else {
+					fmt.Fprintln(w, "Failed")
+				}
+			}),
+			validate: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusTooManyRequests, recorder.Code, "HTTP status")
+				assert.Contains(t, recorder.Body.String(), "My 429 page.")
+				assert.NotContains(t, recorder.Body.String(), "oops", "Should not return the oops page")
+			},
+		},
 	}
 
 	for _, test := range testCases {
@@ -128,6 +148,90 @@ func (m *mockServiceBuilder) BuildHTTP(_ context.Context, serviceName string, re
 	return m.handler, nil
 }
 
+// The rate limiter writes its 429 response itself, so the errors middleware has to wrap it
+// (i.e. be listed before the rate limiter in the router middlewares) to serve the custom page.
+func TestHandlerWithRateLimit(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		chain    func(t *testing.T, next http.Handler, errorPage config.ErrorPage, rateLimit config.RateLimit, serviceBuilder serviceBuilder) http.Handler
+		validate func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			desc: "errors before rate limit",
+			chain: func(t *testing.T, next http.Handler, errorPage config.ErrorPage, rateLimit config.RateLimit, serviceBuilder serviceBuilder) http.Handler {
+				rateLimiter, err := ratelimiter.New(context.Background(), next, rateLimit, "rateLimit")
+				require.NoError(t, err)
+
+				errorPageHandler, err := New(context.Background(), rateLimiter, errorPage, serviceBuilder, "errors")
+				require.NoError(t, err)
+
+				return errorPageHandler
+			},
+			validate: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusTooManyRequests, recorder.Code, "HTTP status")
+				assert.Contains(t, recorder.Body.String(), "My 429 page.")
+				assert.NotContains(t, recorder.Body.String(), "oops", "Should not return the oops page")
+			},
+		},
+		{
+			desc: "rate limit before errors",
+			chain: func(t *testing.T, next http.Handler, errorPage config.ErrorPage, rateLimit config.RateLimit, serviceBuilder serviceBuilder) http.Handler {
+				errorPageHandler, err := New(context.Background(), next, errorPage, serviceBuilder, "errors")
+				require.NoError(t, err)
+
+				rateLimiter, err := ratelimiter.New(context.Background(), errorPageHandler, rateLimit, "rateLimit")
+				require.NoError(t, err)
+
+				return rateLimiter
+			},
+			validate: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusTooManyRequests, recorder.Code, "HTTP status")
+				assert.NotContains(t, recorder.Body.String(), "My 429 page.", "Should not return the custom page since the rate limiter answers first")
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			serviceBuilderMock := &mockServiceBuilder{handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.RequestURI == "/429" {
+					fmt.Fprintln(w, "My 429 page.")
+				} else {
+					fmt.Fprintln(w, "Failed")
+				}
+			})}
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintln(w, http.StatusText(http.StatusOK))
+			})
+
+			errorPage := config.ErrorPage{Service: "error", Query: "/{status}", Status: []string{"429"}}
+			rateLimit := config.RateLimit{
+				ExtractorFunc: "client.ip",
+				RateSet: map[string]*config.Rate{
+					"rate": {Period: parse.Duration(10 * time.Second), Average: 1, Burst: 1},
+				},
+			}
+
+			handler := test.chain(t, next, errorPage, rateLimit, serviceBuilderMock)
+
+			req := testhelpers.MustNewRequest(http.MethodGet, "http://localhost/test", nil)
+			req.RemoteAddr = "10.0.0.1:1234"
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, http.StatusOK, recorder.Code, "HTTP status")
+
+			recorder = httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			test.validate(t, recorder)
+		})
+	}
+}
+
 func TestNewResponseRecorder(t *testing.T) {
 	testCases := []struct {
 		desc     string